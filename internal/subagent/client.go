@@ -0,0 +1,224 @@
+package subagent
+
+import (
+	"sort"
+	"time"
+)
+
+// ListParameters narrows a ListWorkflows/ListOperations query. The zero
+// value means "first page, no filters".
+type ListParameters struct {
+	State         WorkflowState
+	TrinityAspect TrinityAspect
+	Page          int
+	PageSize      int
+
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// pageSize returns p.PageSize, defaulting and capping it to keep a single
+// query bounded.
+func (p ListParameters) pageSize() int {
+	switch {
+	case p.PageSize <= 0:
+		return 50
+	case p.PageSize > 500:
+		return 500
+	default:
+		return p.PageSize
+	}
+}
+
+// page returns the 1-indexed page to return, defaulting to the first page.
+func (p ListParameters) page() int {
+	if p.Page <= 0 {
+		return 1
+	}
+	return p.Page
+}
+
+// WorkflowList is a single page of Workflow results.
+type WorkflowList struct {
+	Workflows []Workflow `json:"workflows"`
+	Page      int        `json:"page"`
+	PageSize  int        `json:"page_size"`
+	Total     int        `json:"total"`
+}
+
+// OperationList is a single page of Operation results.
+type OperationList struct {
+	Operations []Operation `json:"operations"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"page_size"`
+	Total      int         `json:"total"`
+}
+
+// Client exposes read access to a coordinator's workflows and operations,
+// so external tooling (CLIs, dashboards) can query progress without
+// reaching into TrinityCoordinator's internal fields.
+type Client interface {
+	ListWorkflows(params ListParameters) (WorkflowList, error)
+	GetWorkflow(id string) (Workflow, error)
+	ListOperations(workflowID string, params ListParameters) (OperationList, error)
+	GetOperation(workflowID, operationID string) (Operation, error)
+}
+
+// inProcessClient implements Client directly against a TrinityCoordinator
+// running in the same process.
+type inProcessClient struct {
+	tc *TrinityCoordinator
+}
+
+// NewClient returns the default in-process Client for tc.
+func NewClient(tc *TrinityCoordinator) Client {
+	return &inProcessClient{tc: tc}
+}
+
+func (c *inProcessClient) ListWorkflows(params ListParameters) (WorkflowList, error) {
+	c.tc.workflowMu.RLock()
+	defer c.tc.workflowMu.RUnlock()
+
+	matched := make([]Workflow, 0, len(c.tc.activeWorkflows))
+	for _, w := range c.tc.activeWorkflows {
+		if !matchesWorkflow(w, params) {
+			continue
+		}
+		matched = append(matched, *w)
+	}
+
+	// tc.activeWorkflows is a map, so iteration order is randomized; sort
+	// by a stable key before slicing for pagination, or the same Page could
+	// return different, duplicate, or missing items across calls.
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	return paginateWorkflows(matched, params), nil
+}
+
+func (c *inProcessClient) GetWorkflow(id string) (Workflow, error) {
+	c.tc.workflowMu.RLock()
+	defer c.tc.workflowMu.RUnlock()
+
+	w, ok := c.tc.activeWorkflows[id]
+	if !ok {
+		return Workflow{}, NewFieldValidationError("id", "workflow not found: "+id)
+	}
+	return *w, nil
+}
+
+func (c *inProcessClient) ListOperations(workflowID string, params ListParameters) (OperationList, error) {
+	c.tc.operationsMu.RLock()
+	defer c.tc.operationsMu.RUnlock()
+
+	all := c.tc.operations[workflowID]
+	matched := make([]Operation, 0, len(all))
+	for _, op := range all {
+		if params.TrinityAspect != "" && op.TrinityAspect != params.TrinityAspect {
+			continue
+		}
+		if params.State != "" && string(op.Status) != string(params.State) {
+			continue
+		}
+		if !params.CreatedAfter.IsZero() && op.StartTime.Before(params.CreatedAfter) {
+			continue
+		}
+		if !params.CreatedBefore.IsZero() && op.StartTime.After(params.CreatedBefore) {
+			continue
+		}
+		matched = append(matched, op)
+	}
+
+	// tc.operations[workflowID] is append-ordered already, but sort
+	// explicitly by a stable key so pagination stays well-defined even if
+	// that ever changes (e.g. concurrent retries appending out of order).
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].StartTime.Equal(matched[j].StartTime) {
+			return matched[i].StartTime.Before(matched[j].StartTime)
+		}
+		return matched[i].ID < matched[j].ID
+	})
+
+	return paginateOperations(matched, params), nil
+}
+
+func (c *inProcessClient) GetOperation(workflowID, operationID string) (Operation, error) {
+	c.tc.operationsMu.RLock()
+	defer c.tc.operationsMu.RUnlock()
+
+	for _, op := range c.tc.operations[workflowID] {
+		if op.ID == operationID {
+			return op, nil
+		}
+	}
+	return Operation{}, NewFieldValidationError("id", "operation not found: "+operationID)
+}
+
+func matchesWorkflow(w *Workflow, params ListParameters) bool {
+	if params.State != "" && w.State != params.State {
+		return false
+	}
+	if params.TrinityAspect != "" {
+		found := false
+		for _, a := range w.RequiredAspects {
+			if a == params.TrinityAspect {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !params.CreatedAfter.IsZero() && w.CreatedAt.Before(params.CreatedAfter) {
+		return false
+	}
+	if !params.CreatedBefore.IsZero() && w.CreatedAt.After(params.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+func paginateWorkflows(all []Workflow, params ListParameters) WorkflowList {
+	pageSize := params.pageSize()
+	page := params.page()
+	start := (page - 1) * pageSize
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + pageSize
+	if end > len(all) {
+		end = len(all)
+	}
+
+	return WorkflowList{
+		Workflows: all[start:end],
+		Page:      page,
+		PageSize:  pageSize,
+		Total:     len(all),
+	}
+}
+
+func paginateOperations(all []Operation, params ListParameters) OperationList {
+	pageSize := params.pageSize()
+	page := params.page()
+	start := (page - 1) * pageSize
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + pageSize
+	if end > len(all) {
+		end = len(all)
+	}
+
+	return OperationList{
+		Operations: all[start:end],
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      len(all),
+	}
+}
@@ -0,0 +1,61 @@
+package subagent
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestListWorkflowsStablePagination(t *testing.T) {
+	tc := &TrinityCoordinator{activeWorkflows: make(map[string]*Workflow)}
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 25; i++ {
+		id := fmt.Sprintf("wf-%02d", i)
+		tc.activeWorkflows[id] = &Workflow{ID: id, CreatedAt: base.Add(time.Duration(i) * time.Minute)}
+	}
+	client := NewClient(tc)
+
+	var first []string
+	for page := 1; ; page++ {
+		list, err := client.ListWorkflows(ListParameters{Page: page, PageSize: 10})
+		if err != nil {
+			t.Fatalf("ListWorkflows: %v", err)
+		}
+		if len(list.Workflows) == 0 {
+			break
+		}
+		for _, w := range list.Workflows {
+			first = append(first, w.ID)
+		}
+	}
+
+	if len(first) != 25 {
+		t.Fatalf("got %d workflows across pages, want 25", len(first))
+	}
+
+	// Repeating the same paged walk must return the identical order and
+	// set every time, since map iteration order is randomized per range.
+	for attempt := 0; attempt < 5; attempt++ {
+		var again []string
+		for page := 1; ; page++ {
+			list, err := client.ListWorkflows(ListParameters{Page: page, PageSize: 10})
+			if err != nil {
+				t.Fatalf("ListWorkflows: %v", err)
+			}
+			if len(list.Workflows) == 0 {
+				break
+			}
+			for _, w := range list.Workflows {
+				again = append(again, w.ID)
+			}
+		}
+		if len(again) != len(first) {
+			t.Fatalf("attempt %d: got %d ids, want %d", attempt, len(again), len(first))
+		}
+		for i := range first {
+			if again[i] != first[i] {
+				t.Fatalf("attempt %d: pagination order changed at index %d: %q vs %q", attempt, i, again[i], first[i])
+			}
+		}
+	}
+}
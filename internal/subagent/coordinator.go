@@ -0,0 +1,149 @@
+package subagent
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SubmitWorkflow registers wf as active, making it visible through Client
+// and eligible for dispatch via ExecuteWorkflow.
+func (tc *TrinityCoordinator) SubmitWorkflow(wf *Workflow) {
+	tc.workflowMu.Lock()
+	if tc.activeWorkflows == nil {
+		tc.activeWorkflows = make(map[string]*Workflow)
+	}
+	tc.activeWorkflows[wf.ID] = wf
+	tc.workflowMu.Unlock()
+
+	tc.publishActiveWorkflows()
+}
+
+// ExecuteWorkflow runs a previously submitted workflow's steps against the
+// registered agent pool, in order, retrying a failed step per its
+// Task.RetryPolicy before failing the whole workflow.
+func (tc *TrinityCoordinator) ExecuteWorkflow(ctx context.Context, workflowID string) error {
+	tc.workflowMu.RLock()
+	wf, ok := tc.activeWorkflows[workflowID]
+	tc.workflowMu.RUnlock()
+	if !ok {
+		return NewFieldValidationError("id", "workflow not found: "+workflowID)
+	}
+
+	tc.workflowMu.Lock()
+	wf.State = WorkflowStateRunning
+	tc.workflowMu.Unlock()
+
+	for i := range wf.Steps {
+		step := &wf.Steps[i]
+		if err := tc.dispatchStep(ctx, wf, step); err != nil {
+			tc.workflowMu.Lock()
+			wf.State = WorkflowStateFailed
+			tc.workflowMu.Unlock()
+			tc.publishActiveWorkflows()
+			return err
+		}
+
+		tc.workflowMu.Lock()
+		wf.CurrentStep = i + 1
+		wf.UpdatedAt = time.Now()
+		tc.workflowMu.Unlock()
+	}
+
+	tc.workflowMu.Lock()
+	wf.State = WorkflowStateCompleted
+	tc.workflowMu.Unlock()
+	tc.publishActiveWorkflows()
+	return nil
+}
+
+// dispatchStep runs prepareStep (variable injection, then maintenance-window
+// gating) before each attempt, then executes the step against a matching
+// agent, recording and publishing execution/quality metrics for every
+// attempt, retrying per step.Task.RetryPolicy (via handleStepFailure) until
+// it succeeds or is settled at StatusFailed.
+func (tc *TrinityCoordinator) dispatchStep(ctx context.Context, wf *Workflow, step *WorkflowStep) error {
+	attempt := 0
+	for {
+		attempt++
+
+		if err := tc.prepareStep(ctx, wf, step); err != nil {
+			return err
+		}
+
+		agent, err := tc.selectAgent(step)
+		if err != nil {
+			return err
+		}
+
+		result, execErr := agent.Execute(ctx, step.Task)
+
+		rec := ExecutionRecord{
+			TaskID:        step.Task.ID,
+			AgentName:     agent.Name(),
+			TrinityAspect: step.TrinityAspect,
+			Duration:      result.Duration,
+			Status:        result.Status,
+			QualityScore:  result.QualityScore,
+			Timestamp:     time.Now(),
+		}
+		if execErr != nil {
+			rec.Status = StatusFailed
+			rec.ErrorMessage = execErr.Error()
+		}
+
+		tc.recordExecution(rec)
+		tc.recomputeAspectMetrics(rec.TrinityAspect)
+
+		if execErr == nil && rec.Status != StatusFailed {
+			tc.recordOperation(Operation{
+				ID:            fmt.Sprintf("%s:%s:%d", wf.ID, step.ID, attempt),
+				WorkflowID:    wf.ID,
+				StepID:        step.ID,
+				Status:        rec.Status,
+				TrinityAspect: step.TrinityAspect,
+				Attempt:       attempt,
+				StartTime:     result.StartTime,
+				EndTime:       result.EndTime,
+			})
+
+			tc.workflowMu.Lock()
+			if wf.Results == nil {
+				wf.Results = make(map[string]any)
+			}
+			wf.Results[step.ID] = result.Data
+			tc.workflowMu.Unlock()
+			return nil
+		}
+
+		cause := execErr
+		if cause == nil {
+			cause = fmt.Errorf("task %s finished with status %s: %s", step.Task.ID, rec.Status, result.Error)
+		}
+
+		nextStatus, err := tc.handleStepFailure(ctx, wf, step, attempt, cause)
+		if err != nil {
+			return err
+		}
+		if nextStatus == StatusFailed {
+			return NewExecutionError(step.Task.ID, agent.Name(), "step failed and exhausted retries", cause)
+		}
+		// StatusPending: handleStepFailure already waited out the backoff.
+	}
+}
+
+// selectAgent picks the first registered agent for step's Trinity aspect
+// that declares step.Task.Type among its Capabilities.
+func (tc *TrinityCoordinator) selectAgent(step *WorkflowStep) (Agent[any], error) {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+
+	for _, agent := range tc.agents[step.TrinityAspect] {
+		for _, capability := range agent.Capabilities() {
+			if capability == step.Task.Type {
+				return agent, nil
+			}
+		}
+	}
+	return nil, NewFieldValidationError("agent_type", "no registered agent can handle task type: "+step.Task.Type)
+}
@@ -0,0 +1,139 @@
+package subagent
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type stubAgent struct {
+	name   string
+	aspect TrinityAspect
+	execFn func(ctx context.Context, task Task) (Result[any], error)
+}
+
+func (a *stubAgent) Execute(ctx context.Context, task Task) (Result[any], error) {
+	return a.execFn(ctx, task)
+}
+func (a *stubAgent) Name() string                 { return a.name }
+func (a *stubAgent) Capabilities() []string       { return []string{"build"} }
+func (a *stubAgent) TrinityAspect() TrinityAspect { return a.aspect }
+
+func newTestWorkflow(step WorkflowStep) *Workflow {
+	return &Workflow{
+		ID:    "wf-1",
+		State: WorkflowStatePending,
+		Steps: []WorkflowStep{step},
+	}
+}
+
+func TestExecuteWorkflowSuccess(t *testing.T) {
+	tc := &TrinityCoordinator{}
+	tc.RegisterAgent(&stubAgent{
+		name:   "krukai-builder",
+		aspect: KrukaiAspect,
+		execFn: func(ctx context.Context, task Task) (Result[any], error) {
+			return Result[any]{
+				TaskID: task.ID,
+				Status: StatusCompleted,
+				Data:   "artifact.tar",
+			}, nil
+		},
+	})
+
+	wf := newTestWorkflow(WorkflowStep{
+		ID:            "build",
+		TrinityAspect: KrukaiAspect,
+		Task:          Task{ID: "t1", Type: "build"},
+	})
+	tc.SubmitWorkflow(wf)
+
+	if err := tc.ExecuteWorkflow(context.Background(), wf.ID); err != nil {
+		t.Fatalf("ExecuteWorkflow: %v", err)
+	}
+	if wf.State != WorkflowStateCompleted {
+		t.Fatalf("got state %v, want completed", wf.State)
+	}
+	if wf.Results["build"] != "artifact.tar" {
+		t.Fatalf("got results %v", wf.Results)
+	}
+}
+
+func TestExecuteWorkflowRetriesThenFails(t *testing.T) {
+	tc := &TrinityCoordinator{}
+	attempts := 0
+	tc.RegisterAgent(&stubAgent{
+		name:   "krukai-builder",
+		aspect: KrukaiAspect,
+		execFn: func(ctx context.Context, task Task) (Result[any], error) {
+			attempts++
+			return Result[any]{TaskID: task.ID, Status: StatusFailed, Error: "boom"}, nil
+		},
+	})
+
+	wf := newTestWorkflow(WorkflowStep{
+		ID:            "build",
+		TrinityAspect: KrukaiAspect,
+		Task: Task{
+			ID:   "t1",
+			Type: "build",
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts:  3,
+				InitialDelay: time.Millisecond,
+				MaxDelay:     2 * time.Millisecond,
+			},
+		},
+	})
+	tc.SubmitWorkflow(wf)
+
+	if err := tc.ExecuteWorkflow(context.Background(), wf.ID); err == nil {
+		t.Fatal("expected ExecuteWorkflow to fail after exhausting retries")
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+	if wf.State != WorkflowStateFailed {
+		t.Fatalf("got state %v, want failed", wf.State)
+	}
+}
+
+func TestExecuteWorkflowRecordsAspectMetrics(t *testing.T) {
+	tc := &TrinityCoordinator{}
+	tc.RegisterAgent(&stubAgent{
+		name:   "krukai-builder",
+		aspect: KrukaiAspect,
+		execFn: func(ctx context.Context, task Task) (Result[any], error) {
+			return Result[any]{
+				TaskID:       task.ID,
+				Status:       StatusCompleted,
+				QualityScore: 0.9,
+			}, nil
+		},
+	})
+
+	wf := newTestWorkflow(WorkflowStep{
+		ID:            "build",
+		TrinityAspect: KrukaiAspect,
+		Task:          Task{ID: "t1", Type: "build"},
+	})
+	tc.SubmitWorkflow(wf)
+
+	if err := tc.ExecuteWorkflow(context.Background(), wf.ID); err != nil {
+		t.Fatalf("ExecuteWorkflow: %v", err)
+	}
+
+	if len(tc.executionHistory) != 1 {
+		t.Fatalf("got %d execution records, want 1", len(tc.executionHistory))
+	}
+
+	m := tc.qualityMetrics.AspectMetrics[KrukaiAspect]
+	if m.ExecutionCount != 1 {
+		t.Fatalf("got ExecutionCount %d, want 1", m.ExecutionCount)
+	}
+	if m.SuccessRate != 1 {
+		t.Fatalf("got SuccessRate %v, want 1", m.SuccessRate)
+	}
+	if m.AverageQuality != 0.9 {
+		t.Fatalf("got AverageQuality %v, want 0.9", m.AverageQuality)
+	}
+}
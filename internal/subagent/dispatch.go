@@ -0,0 +1,29 @@
+package subagent
+
+import "context"
+
+// SetInjector configures the Injector used to resolve "$(VAR)" references
+// before each step dispatch. A nil Injector (the default) skips resolution
+// entirely.
+func (tc *TrinityCoordinator) SetInjector(injector *Injector) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.injector = injector
+}
+
+// prepareStep runs the per-step pipeline that must happen before a
+// WorkflowStep is handed to an agent: variable interpolation, then waiting
+// out any maintenance-window restriction on wf.Schedule.
+func (tc *TrinityCoordinator) prepareStep(ctx context.Context, wf *Workflow, step *WorkflowStep) error {
+	tc.mu.RLock()
+	injector := tc.injector
+	tc.mu.RUnlock()
+
+	if injector != nil {
+		if err := injector.Inject(ctx, wf, step); err != nil {
+			return err
+		}
+	}
+
+	return tc.awaitMaintenanceWindow(ctx, wf)
+}
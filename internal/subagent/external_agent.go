@@ -0,0 +1,237 @@
+package subagent
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	subagentv1 "github.com/apto-as/trinitas-agents/internal/subagent/v1"
+)
+
+// ExternalAgentConfig describes a remote agent endpoint to dial.
+type ExternalAgentConfig struct {
+	// Endpoint is the gRPC target, e.g. "agents-krukai.internal:9443".
+	Endpoint string
+
+	// TLSConfig, if non-nil, enables mTLS for this endpoint. It is
+	// mandatory when SecurityLevel is SecurityRestricted or above.
+	TLSConfig *tls.Config
+
+	// SecurityLevel is the maximum SecurityLevel this endpoint is trusted
+	// to handle. NewExternalAgent refuses a plaintext endpoint at
+	// SecurityRestricted, and Execute sends the level with every request
+	// and stamps it on the Result; Execute does not itself refuse a task.
+	SecurityLevel SecurityLevel
+
+	// ReconnectBackoff bounds the dial retry backoff. Zero uses
+	// DefaultReconnectBackoff.
+	ReconnectBackoff ReconnectBackoff
+}
+
+// ReconnectBackoff configures exponential backoff for reconnecting to a
+// remote agent after a transport failure.
+type ReconnectBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+// DefaultReconnectBackoff starts at one second, like gRPC's own default
+// connection backoff, but caps retries at 30 seconds instead of gRPC's 120.
+func DefaultReconnectBackoff() ReconnectBackoff {
+	return ReconnectBackoff{Initial: time.Second, Max: 30 * time.Second}
+}
+
+// ExternalAgent adapts a remote process speaking the subagent.v1.AgentService
+// gRPC protocol to the in-process Agent[any] interface, so Trinity agents
+// written in any language can be registered with a TrinityCoordinator
+// without being compiled into the Go host binary.
+type ExternalAgent struct {
+	cfg    ExternalAgentConfig
+	conn   *grpc.ClientConn
+	client subagentv1.AgentServiceClient
+
+	name         string
+	aspect       TrinityAspect
+	capabilities []string
+}
+
+// NewExternalAgent dials the remote endpoint described by cfg and fetches its
+// capabilities. mTLS is required whenever cfg.SecurityLevel is
+// SecurityRestricted; a plaintext endpoint is refused outright.
+func NewExternalAgent(ctx context.Context, cfg ExternalAgentConfig) (*ExternalAgent, error) {
+	if cfg.SecurityLevel >= SecurityRestricted && cfg.TLSConfig == nil {
+		return nil, NewValidationError("mTLS is required for SecurityRestricted external agent endpoints")
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.TLSConfig != nil {
+		creds = credentials.NewTLS(cfg.TLSConfig)
+	}
+
+	backoffCfg := cfg.ReconnectBackoff
+	if backoffCfg.Initial <= 0 {
+		backoffCfg = DefaultReconnectBackoff()
+	}
+
+	backoffConfig := backoff.DefaultConfig
+	backoffConfig.BaseDelay = backoffCfg.Initial
+	backoffConfig.MaxDelay = backoffCfg.Max
+
+	conn, err := grpc.DialContext(ctx, cfg.Endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoffConfig,
+		}),
+	)
+	if err != nil {
+		return nil, NewExecutionError("", cfg.Endpoint, "failed to dial external agent", err)
+	}
+
+	ea := &ExternalAgent{
+		cfg:    cfg,
+		conn:   conn,
+		client: subagentv1.NewAgentServiceClient(conn),
+	}
+
+	if err := ea.refreshCapabilities(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return ea, nil
+}
+
+func (ea *ExternalAgent) refreshCapabilities(ctx context.Context) error {
+	resp, err := ea.client.GetCapabilities(ctx, &subagentv1.Empty{})
+	if err != nil {
+		return NewExecutionError("", ea.cfg.Endpoint, "failed to fetch external agent capabilities", err)
+	}
+	ea.name = resp.Name
+	ea.aspect = TrinityAspect(resp.TrinityAspect)
+	ea.capabilities = resp.Capabilities
+	return nil
+}
+
+// Close releases the underlying gRPC connection.
+func (ea *ExternalAgent) Close() error {
+	return ea.conn.Close()
+}
+
+// Name implements Agent[any].
+func (ea *ExternalAgent) Name() string { return ea.name }
+
+// Capabilities implements Agent[any].
+func (ea *ExternalAgent) Capabilities() []string { return ea.capabilities }
+
+// TrinityAspect implements Agent[any].
+func (ea *ExternalAgent) TrinityAspect() TrinityAspect { return ea.aspect }
+
+// Execute implements Agent[any] by streaming the task to the remote agent
+// and folding progress events into Result.Warnings/QualityScore until the
+// terminal event arrives.
+func (ea *ExternalAgent) Execute(ctx context.Context, task Task) (Result[any], error) {
+	result := Result[any]{
+		TaskID:        task.ID,
+		ExecutedBy:    ea.name,
+		TrinityAspect: ea.aspect,
+		SecurityLevel: ea.cfg.SecurityLevel,
+		StartTime:     time.Now(),
+	}
+
+	req, err := ea.buildRequest(task)
+	if err != nil {
+		return result, err
+	}
+
+	stream, err := ea.client.Execute(ctx, req)
+	if err != nil {
+		return result, NewExecutionError(task.ID, ea.name, "failed to start remote execution", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return result, NewExecutionError(task.ID, ea.name, "remote stream closed without a terminal event", nil)
+		}
+		if err != nil {
+			return result, NewExecutionError(task.ID, ea.name, "remote execution stream failed", err)
+		}
+
+		if progress := event.GetProgress(); progress != nil {
+			if progress.Warning != "" {
+				result.Warnings = append(result.Warnings, progress.Warning)
+			}
+			if progress.QualityScore > 0 {
+				result.QualityScore = progress.QualityScore
+			}
+			continue
+		}
+
+		terminal := event.GetTerminal()
+		if terminal == nil {
+			return result, NewExecutionError(task.ID, ea.name, "received event with neither progress nor terminal payload", nil)
+		}
+
+		result.Status = Status(terminal.Status)
+		result.Error = terminal.Error
+		result.QualityScore = terminal.QualityScore
+		result.Warnings = append(result.Warnings, terminal.Warnings...)
+		result.EndTime = time.Now()
+		result.Duration = result.EndTime.Sub(result.StartTime)
+
+		if len(terminal.DataJson) > 0 {
+			var data any
+			if err := json.Unmarshal(terminal.DataJson, &data); err != nil {
+				return result, NewExecutionError(task.ID, ea.name, "failed to decode remote result payload", err)
+			}
+			result.Data = data
+		}
+
+		return result, nil
+	}
+}
+
+func (ea *ExternalAgent) buildRequest(task Task) (*subagentv1.TaskRequest, error) {
+	payload, err := json.Marshal(task.Payload)
+	if err != nil {
+		return nil, NewExecutionError(task.ID, ea.name, "failed to encode task payload", err)
+	}
+
+	aspects := make([]string, len(task.RequiredAspects))
+	for i, a := range task.RequiredAspects {
+		aspects[i] = string(a)
+	}
+
+	return &subagentv1.TaskRequest{
+		Id:              task.ID,
+		Type:            task.Type,
+		Description:     task.Description,
+		PayloadJson:     payload,
+		Priority:        int32(task.Priority),
+		TimeoutMs:       task.Timeout.Milliseconds(),
+		RequiredAspects: aspects,
+		Dependencies:    task.Dependencies,
+		SecurityLevel:   int32(ea.cfg.SecurityLevel),
+	}, nil
+}
+
+// HealthCheck reports whether the remote agent currently accepts work.
+func (ea *ExternalAgent) HealthCheck(ctx context.Context) error {
+	resp, err := ea.client.HealthCheck(ctx, &subagentv1.Empty{})
+	if err != nil {
+		return NewExecutionError("", ea.name, "health check failed", err)
+	}
+	if !resp.Healthy {
+		return fmt.Errorf("external agent %q reports unhealthy: %s", ea.name, resp.Message)
+	}
+	return nil
+}
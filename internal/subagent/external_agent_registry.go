@@ -0,0 +1,32 @@
+package subagent
+
+import "context"
+
+// RegisterExternalAgents dials every endpoint in configs and registers the
+// resulting ExternalAgent with the coordinator, so Trinity agents written in
+// any language can join a workflow without being compiled into the host
+// binary. Dialing happens sequentially; the first endpoint that fails to
+// connect or report capabilities aborts the whole batch, leaving previously
+// registered agents in place.
+func (tc *TrinityCoordinator) RegisterExternalAgents(ctx context.Context, configs []ExternalAgentConfig) error {
+	for _, cfg := range configs {
+		agent, err := NewExternalAgent(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		tc.RegisterAgent(agent)
+	}
+	return nil
+}
+
+// RegisterAgent adds agent to the pool available for its Trinity aspect.
+func (tc *TrinityCoordinator) RegisterAgent(agent Agent[any]) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.agents == nil {
+		tc.agents = make(map[TrinityAspect][]Agent[any])
+	}
+	aspect := agent.TrinityAspect()
+	tc.agents[aspect] = append(tc.agents[aspect], agent)
+}
@@ -0,0 +1,179 @@
+package subagent
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	subagentv1 "github.com/apto-as/trinitas-agents/internal/subagent/v1"
+)
+
+// stubAgentServer implements subagentv1.AgentServiceServer against
+// in-memory fixtures, so ExternalAgent can be exercised without a real
+// remote process.
+type stubAgentServer struct {
+	subagentv1.UnimplementedAgentServiceServer
+
+	name         string
+	aspect       string
+	capabilities []string
+	events       []*subagentv1.ExecutionEvent
+}
+
+func (s *stubAgentServer) GetCapabilities(ctx context.Context, _ *subagentv1.Empty) (*subagentv1.CapabilitiesResponse, error) {
+	return &subagentv1.CapabilitiesResponse{
+		Name:          s.name,
+		TrinityAspect: s.aspect,
+		Capabilities:  s.capabilities,
+	}, nil
+}
+
+func (s *stubAgentServer) Execute(req *subagentv1.TaskRequest, stream subagentv1.AgentService_ExecuteServer) error {
+	for _, event := range s.events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *stubAgentServer) HealthCheck(ctx context.Context, _ *subagentv1.Empty) (*subagentv1.HealthCheckResponse, error) {
+	return &subagentv1.HealthCheckResponse{Healthy: true}, nil
+}
+
+// dialStubAgent starts srv on an in-memory bufconn listener and returns an
+// ExternalAgent dialed against it.
+func dialStubAgent(t *testing.T, srv *stubAgentServer) *ExternalAgent {
+	t.Helper()
+
+	const bufSize = 1024 * 1024
+	lis := bufconn.Listen(bufSize)
+	t.Cleanup(func() { lis.Close() })
+
+	s := grpc.NewServer()
+	subagentv1.RegisterAgentServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dialing bufconn: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	ea := &ExternalAgent{
+		cfg:    ExternalAgentConfig{Endpoint: "bufnet"},
+		conn:   conn,
+		client: subagentv1.NewAgentServiceClient(conn),
+	}
+	if err := ea.refreshCapabilities(ctx); err != nil {
+		t.Fatalf("refreshCapabilities: %v", err)
+	}
+	return ea
+}
+
+func TestNewExternalAgentRequiresTLSForRestricted(t *testing.T) {
+	_, err := NewExternalAgent(context.Background(), ExternalAgentConfig{
+		Endpoint:      "agents-krukai.internal:9443",
+		SecurityLevel: SecurityRestricted,
+	})
+	if err == nil {
+		t.Fatal("expected NewExternalAgent to refuse a plaintext config at SecurityRestricted")
+	}
+}
+
+func TestExternalAgentRefreshCapabilities(t *testing.T) {
+	ea := dialStubAgent(t, &stubAgentServer{
+		name:         "krukai-builder",
+		aspect:       string(KrukaiAspect),
+		capabilities: []string{"build", "lint"},
+	})
+
+	if ea.Name() != "krukai-builder" {
+		t.Fatalf("got name %q, want krukai-builder", ea.Name())
+	}
+	if ea.TrinityAspect() != KrukaiAspect {
+		t.Fatalf("got aspect %q, want %q", ea.TrinityAspect(), KrukaiAspect)
+	}
+	if len(ea.Capabilities()) != 2 {
+		t.Fatalf("got capabilities %v, want 2 entries", ea.Capabilities())
+	}
+}
+
+func TestExternalAgentExecuteFoldsProgressAndTerminal(t *testing.T) {
+	ea := dialStubAgent(t, &stubAgentServer{
+		name:   "krukai-builder",
+		aspect: string(KrukaiAspect),
+		events: []*subagentv1.ExecutionEvent{
+			{
+				TaskId: "t1",
+				Event: &subagentv1.ExecutionEvent_Progress{
+					Progress: &subagentv1.ProgressEvent{Warning: "disk usage high", QualityScore: 0.5},
+				},
+			},
+			{
+				TaskId: "t1",
+				Event: &subagentv1.ExecutionEvent_Terminal{
+					Terminal: &subagentv1.TerminalEvent{
+						Status:       string(StatusCompleted),
+						QualityScore: 0.9,
+						Warnings:     []string{"retried once"},
+						DataJson:     []byte(`{"artifact":"build.tar"}`),
+					},
+				},
+			},
+		},
+	})
+
+	result, err := ea.Execute(context.Background(), Task{ID: "t1", Type: "build"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.Status != StatusCompleted {
+		t.Fatalf("got status %v, want completed", result.Status)
+	}
+	if result.QualityScore != 0.9 {
+		t.Fatalf("got quality score %v, want the terminal event's 0.9, not the progress event's 0.5", result.QualityScore)
+	}
+	if len(result.Warnings) != 2 || result.Warnings[0] != "disk usage high" || result.Warnings[1] != "retried once" {
+		t.Fatalf("got warnings %v, want both progress and terminal warnings folded in order", result.Warnings)
+	}
+	data, ok := result.Data.(map[string]any)
+	if !ok || data["artifact"] != "build.tar" {
+		t.Fatalf("got data %#v, want decoded DataJson", result.Data)
+	}
+}
+
+func TestRegisterExternalAgentsPartialFailureKeepsPriorAgents(t *testing.T) {
+	tc := &TrinityCoordinator{}
+	ea := dialStubAgent(t, &stubAgentServer{
+		name:         "krukai-builder",
+		aspect:       string(KrukaiAspect),
+		capabilities: []string{"build"},
+	})
+	tc.RegisterAgent(ea)
+
+	err := tc.RegisterExternalAgents(context.Background(), []ExternalAgentConfig{
+		{Endpoint: "does-not-resolve.invalid:1", SecurityLevel: SecurityRestricted},
+	})
+	if err == nil {
+		t.Fatal("expected RegisterExternalAgents to fail for an endpoint requiring mTLS with none configured")
+	}
+
+	agents := tc.agents[KrukaiAspect]
+	if len(agents) != 1 || agents[0].Name() != "krukai-builder" {
+		t.Fatalf("got agents %v, want the agent registered before the failing endpoint to remain", agents)
+	}
+}
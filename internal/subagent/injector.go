@@ -0,0 +1,205 @@
+package subagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var placeholderPattern = regexp.MustCompile(`\$\(([^)]+)\)`)
+
+// Injector resolves "$(VAR)" references inside a Task's Payload and
+// Description (including the Task embedded in a WorkflowStep) from three
+// sources, in precedence order:
+//
+//  1. prior step results, via "$(steps.<step_id>.<path>)" into
+//     Workflow.Results
+//  2. per-workflow Variables supplied at submit time
+//  3. the process environment, restricted to AllowedEnvVars
+//
+// This lets a step declare what it needs from upstream output without an
+// agent reaching into globals to discover it.
+type Injector struct {
+	// StrictMode turns an unresolved reference into an error. When false,
+	// the "$(...)" text is left verbatim in the string.
+	StrictMode bool
+
+	// AllowedEnvVars lists the only environment variable names the process
+	// environment source may resolve. A Task's Payload/Description is
+	// effectively untrusted input (authored by whoever submits the
+	// workflow), so without this allow-list a "$(VAR)" reference could be
+	// used to exfiltrate arbitrary process environment (credentials,
+	// tokens) into a step result. A nil or empty map disables the
+	// environment source entirely.
+	AllowedEnvVars map[string]bool
+}
+
+// NewInjector creates an Injector with the given strictness. The process
+// environment source is disabled until AllowedEnvVars is set.
+func NewInjector(strict bool) *Injector {
+	return &Injector{StrictMode: strict}
+}
+
+// Inject resolves all references in step.Task against w, mutating
+// step.Task.Payload and step.Task.Description in place. ctx is accepted for
+// future cancellable lookups (e.g. a remote variable store) and is not used
+// by the built-in sources.
+func (in *Injector) Inject(ctx context.Context, w *Workflow, step *WorkflowStep) error {
+	desc, err := in.resolveString(step.Task.Description, w)
+	if err != nil {
+		return fmt.Errorf("resolving task description: %w", err)
+	}
+	// Description is plain text, so a resolved non-string value (e.g. a
+	// step result that happens to be a number) is stringified rather than
+	// preserved, unlike payload fields.
+	if s, ok := desc.(string); ok {
+		step.Task.Description = s
+	} else {
+		step.Task.Description = fmt.Sprintf("%v", desc)
+	}
+
+	if step.Task.Payload == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(step.Task.Payload)
+	if err != nil {
+		return fmt.Errorf("encoding task payload: %w", err)
+	}
+
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return fmt.Errorf("decoding task payload: %w", err)
+	}
+
+	resolved, err := in.resolveValue(generic, w)
+	if err != nil {
+		return err
+	}
+
+	payload, ok := resolved.(map[string]any)
+	if !ok {
+		return NewValidationError("task payload must decode to a JSON object")
+	}
+	step.Task.Payload = payload
+	return nil
+}
+
+// resolveValue walks a decoded JSON value, substituting placeholders in
+// strings. A string that is entirely a single "$(...)" placeholder is
+// replaced with the resolved value directly, so numbers/bools survive
+// instead of being stringified.
+func (in *Injector) resolveValue(v any, w *Workflow) (any, error) {
+	switch val := v.(type) {
+	case string:
+		return in.resolveString(val, w)
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, child := range val {
+			resolved, err := in.resolveValue(child, w)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = resolved
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(val))
+		for i, child := range val {
+			resolved, err := in.resolveValue(child, w)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	default:
+		return val, nil
+	}
+}
+
+// resolveString substitutes every "$(VAR)" reference in s. If s is a single
+// placeholder and nothing else, the resolved value's native type is
+// returned instead of a string.
+func (in *Injector) resolveString(s string, w *Workflow) (any, error) {
+	matches := placeholderPattern.FindStringSubmatch(s)
+	if matches != nil && matches[0] == s {
+		value, ok := in.resolve(matches[1], w)
+		if !ok {
+			if in.StrictMode {
+				return nil, NewValidationError("unresolved reference: " + s)
+			}
+			return s, nil
+		}
+		return value, nil
+	}
+
+	var firstErr error
+	result := placeholderPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if firstErr != nil {
+			return match
+		}
+		ref := placeholderPattern.FindStringSubmatch(match)[1]
+		value, ok := in.resolve(ref, w)
+		if !ok {
+			if in.StrictMode {
+				firstErr = NewValidationError("unresolved reference: " + match)
+			}
+			return match
+		}
+		return fmt.Sprintf("%v", value)
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+// resolve looks up a single "$(...)" reference body against the three
+// Injector sources, in precedence order.
+func (in *Injector) resolve(ref string, w *Workflow) (any, bool) {
+	if strings.HasPrefix(ref, "steps.") {
+		return resolvePath(w.Results, strings.Split(ref, ".")[1:])
+	}
+	if w.Variables != nil {
+		if value, ok := w.Variables[ref]; ok {
+			return value, true
+		}
+	}
+	if in.AllowedEnvVars[ref] {
+		if value, ok := os.LookupEnv(ref); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// resolvePath descends into a map[string]any tree following parts, e.g.
+// ["build", "artifact_url"]. results[step_id] holds that step's
+// Result.Data as stored by dispatchStep; descending past it requires the
+// Data to be a map[string]any, such as ExternalAgent's JSON-decoded data.
+func resolvePath(results map[string]any, parts []string) (any, bool) {
+	if len(parts) == 0 || results == nil {
+		return nil, false
+	}
+
+	current, ok := results[parts[0]]
+	if !ok {
+		return nil, false
+	}
+
+	for _, part := range parts[1:] {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,72 @@
+package subagent
+
+import (
+	"context"
+	"testing"
+)
+
+func TestInjectorEnvRequiresAllowList(t *testing.T) {
+	t.Setenv("TRINITY_TEST_SECRET", "super-secret")
+
+	wf := &Workflow{Results: map[string]any{}}
+	step := &WorkflowStep{Task: Task{Description: "$(TRINITY_TEST_SECRET)"}}
+
+	in := NewInjector(false)
+	if err := in.Inject(context.Background(), wf, step); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+	if step.Task.Description == "super-secret" {
+		t.Fatal("resolved env var without it being on AllowedEnvVars")
+	}
+	if step.Task.Description != "$(TRINITY_TEST_SECRET)" {
+		t.Fatalf("got %q, want the placeholder left verbatim", step.Task.Description)
+	}
+
+	in.AllowedEnvVars = map[string]bool{"TRINITY_TEST_SECRET": true}
+	step = &WorkflowStep{Task: Task{Description: "$(TRINITY_TEST_SECRET)"}}
+	if err := in.Inject(context.Background(), wf, step); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+	if step.Task.Description != "super-secret" {
+		t.Fatalf("got %q, want resolved value once allow-listed", step.Task.Description)
+	}
+}
+
+func TestInjectorResolvesStepsAndVariablesBeforeEnv(t *testing.T) {
+	t.Setenv("TRINITY_TEST_PRECEDENCE", "from-env")
+
+	wf := &Workflow{
+		Results:   map[string]any{"build": map[string]any{"artifact_url": "s3://build/artifact.tar"}},
+		Variables: map[string]any{"TRINITY_TEST_PRECEDENCE": "us-east-1"},
+	}
+	step := &WorkflowStep{
+		Task: Task{
+			Payload: map[string]any{
+				"url":    "$(steps.build.artifact_url)",
+				"region": "$(TRINITY_TEST_PRECEDENCE)",
+			},
+		},
+	}
+
+	in := NewInjector(true)
+	in.AllowedEnvVars = map[string]bool{"TRINITY_TEST_PRECEDENCE": true}
+	if err := in.Inject(context.Background(), wf, step); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+	if step.Task.Payload["url"] != "s3://build/artifact.tar" {
+		t.Fatalf("got url %v, want resolved step result", step.Task.Payload["url"])
+	}
+	if step.Task.Payload["region"] != "us-east-1" {
+		t.Fatalf("got region %v, want the workflow variable over the allow-listed env var", step.Task.Payload["region"])
+	}
+}
+
+func TestInjectorStrictModeErrorsOnUnresolved(t *testing.T) {
+	wf := &Workflow{}
+	step := &WorkflowStep{Task: Task{Description: "$(missing.ref)"}}
+
+	in := NewInjector(true)
+	if err := in.Inject(context.Background(), wf, step); err == nil {
+		t.Fatal("expected an error for an unresolved reference in strict mode")
+	}
+}
@@ -0,0 +1,41 @@
+// Package metrics exports Trinity execution and quality data for scraping,
+// typically by Prometheus. Collector takes plain values rather than
+// subagent types so this package has no dependency on package subagent and
+// the two can't form an import cycle.
+package metrics
+
+import "net/http"
+
+// Collector records coordinator execution and quality data and serves it
+// for scraping. NewCollector returns the Prometheus-backed implementation
+// when built with the "prometheus" build tag, and a Nop implementation
+// otherwise, so embedders that don't want the Prometheus dependency can
+// still compile.
+type Collector interface {
+	// RecordExecution is called once per ExecutionRecord the coordinator
+	// appends.
+	RecordExecution(aspect, agent, status string, durationSeconds float64, memoryBytes int64)
+
+	// SetActiveWorkflows reports the current count of in-flight workflows.
+	SetActiveWorkflows(n int)
+
+	// SetAspectQuality reports the latest rolling quality figures for a
+	// single Trinity aspect, including its TrendDirection as an enum label.
+	SetAspectQuality(aspect string, averageQuality, successRate float64, trend string)
+
+	// Handler serves the collected metrics, e.g. for mounting at /metrics.
+	Handler() http.Handler
+}
+
+var defaultCollector = NewCollector()
+
+// Default returns the package-level Collector used by Handler and wired
+// into a TrinityCoordinator unless SetMetricsCollector overrides it.
+func Default() Collector {
+	return defaultCollector
+}
+
+// Handler serves the package-level Collector's metrics.
+func Handler() http.Handler {
+	return defaultCollector.Handler()
+}
@@ -0,0 +1,30 @@
+//go:build !prometheus
+
+package metrics
+
+import "net/http"
+
+// nopCollector discards everything it's given. It's the default Collector
+// so embedders don't pull in the Prometheus dependency unless they opt in
+// by building with the "prometheus" tag.
+type nopCollector struct{}
+
+// NewCollector returns a Collector that discards all data. Build with the
+// "prometheus" tag to get the real Prometheus-backed implementation.
+func NewCollector() Collector {
+	return nopCollector{}
+}
+
+func (nopCollector) RecordExecution(aspect, agent, status string, durationSeconds float64, memoryBytes int64) {
+}
+
+func (nopCollector) SetActiveWorkflows(n int) {}
+
+func (nopCollector) SetAspectQuality(aspect string, averageQuality, successRate float64, trend string) {
+}
+
+func (nopCollector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `metrics collection disabled (build with the "prometheus" tag to enable it)`, http.StatusNotImplemented)
+	})
+}
@@ -0,0 +1,104 @@
+//go:build prometheus
+
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// trendDirections lists every TrendDirection label value so the trend
+// gauge always reports a row per direction, even the ones not currently in
+// effect, rather than only ever appearing once set.
+var trendDirections = []string{"improving", "stable", "declining", "unknown"}
+
+type prometheusCollector struct {
+	tasksTotal      *prometheus.CounterVec
+	taskDuration    *prometheus.HistogramVec
+	taskMemory      *prometheus.HistogramVec
+	activeWorkflows prometheus.Gauge
+	averageQuality  *prometheus.GaugeVec
+	successRate     *prometheus.GaugeVec
+	trend           *prometheus.GaugeVec
+	registry        *prometheus.Registry
+}
+
+// NewCollector registers and returns the Prometheus-backed Collector.
+func NewCollector() Collector {
+	reg := prometheus.NewRegistry()
+
+	c := &prometheusCollector{
+		tasksTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "trinity_tasks_total",
+			Help: "Total number of Trinity agent task executions.",
+		}, []string{"aspect", "status"}),
+		taskDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "trinity_task_duration_seconds",
+			Help:    "Task execution duration in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"aspect", "agent"}),
+		taskMemory: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "trinity_task_memory_bytes",
+			Help:    "Task memory usage in bytes.",
+			Buckets: prometheus.ExponentialBuckets(1<<20, 2, 10),
+		}, []string{"aspect"}),
+		activeWorkflows: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "trinity_active_workflows",
+			Help: "Number of workflows currently active.",
+		}),
+		averageQuality: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "trinity_aspect_average_quality",
+			Help: "Rolling average quality score per Trinity aspect.",
+		}, []string{"aspect"}),
+		successRate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "trinity_aspect_success_rate",
+			Help: "Rolling success rate per Trinity aspect.",
+		}, []string{"aspect"}),
+		trend: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "trinity_aspect_quality_trend",
+			Help: "1 for the current TrendDirection of a Trinity aspect, 0 otherwise.",
+		}, []string{"aspect", "trend"}),
+		registry: reg,
+	}
+
+	reg.MustRegister(
+		c.tasksTotal,
+		c.taskDuration,
+		c.taskMemory,
+		c.activeWorkflows,
+		c.averageQuality,
+		c.successRate,
+		c.trend,
+	)
+
+	return c
+}
+
+func (c *prometheusCollector) RecordExecution(aspect, agent, status string, durationSeconds float64, memoryBytes int64) {
+	c.tasksTotal.WithLabelValues(aspect, status).Inc()
+	c.taskDuration.WithLabelValues(aspect, agent).Observe(durationSeconds)
+	c.taskMemory.WithLabelValues(aspect).Observe(float64(memoryBytes))
+}
+
+func (c *prometheusCollector) SetActiveWorkflows(n int) {
+	c.activeWorkflows.Set(float64(n))
+}
+
+func (c *prometheusCollector) SetAspectQuality(aspect string, averageQuality, successRate float64, trend string) {
+	c.averageQuality.WithLabelValues(aspect).Set(averageQuality)
+	c.successRate.WithLabelValues(aspect).Set(successRate)
+
+	for _, direction := range trendDirections {
+		value := 0.0
+		if direction == trend {
+			value = 1.0
+		}
+		c.trend.WithLabelValues(aspect, direction).Set(value)
+	}
+}
+
+func (c *prometheusCollector) Handler() http.Handler {
+	return promhttp.HandlerFor(c.registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,114 @@
+package subagent
+
+import (
+	"time"
+
+	"github.com/apto-as/trinitas-agents/internal/subagent/metrics"
+)
+
+// SetMetricsCollector overrides the Collector used to export execution and
+// quality data. The default is metrics.Default(), a no-op unless the binary
+// is built with the "prometheus" tag.
+func (tc *TrinityCoordinator) SetMetricsCollector(collector metrics.Collector) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.metricsCollector = collector
+}
+
+func (tc *TrinityCoordinator) collector() metrics.Collector {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+	if tc.metricsCollector != nil {
+		return tc.metricsCollector
+	}
+	return metrics.Default()
+}
+
+// recordExecution appends rec to the in-memory execution history and
+// forwards it to the configured metrics Collector.
+func (tc *TrinityCoordinator) recordExecution(rec ExecutionRecord) {
+	tc.mu.Lock()
+	tc.executionHistory = append(tc.executionHistory, rec)
+	tc.mu.Unlock()
+
+	tc.collector().RecordExecution(string(rec.TrinityAspect), rec.AgentName, string(rec.Status), rec.Duration.Seconds(), rec.MemoryUsed)
+}
+
+// publishActiveWorkflows reports the current count of in-flight workflows
+// (submitted and not yet completed, failed or cancelled) to the metrics
+// Collector.
+func (tc *TrinityCoordinator) publishActiveWorkflows() {
+	tc.workflowMu.RLock()
+	n := 0
+	for _, w := range tc.activeWorkflows {
+		switch w.State {
+		case WorkflowStateCompleted, WorkflowStateFailed, WorkflowStateCancelled:
+			continue
+		}
+		n++
+	}
+	tc.workflowMu.RUnlock()
+	tc.collector().SetActiveWorkflows(n)
+}
+
+// publishAspectQuality reports an aspect's latest rolling metrics to the
+// Collector; called whenever qualityMetrics is recomputed.
+func (tc *TrinityCoordinator) publishAspectQuality(aspect TrinityAspect, m AspectQualityMetrics, trend TrendDirection) {
+	tc.collector().SetAspectQuality(string(aspect), m.AverageQuality, m.SuccessRate, string(trend))
+}
+
+// recomputeAspectMetrics recalculates aspect's rolling AspectQualityMetrics
+// from tc.executionHistory and publishes the result to the metrics
+// Collector. Called after every recordExecution for the aspect that just
+// ran a step.
+func (tc *TrinityCoordinator) recomputeAspectMetrics(aspect TrinityAspect) {
+	tc.mu.Lock()
+
+	var (
+		count    int64
+		success  int64
+		quality  float64
+		duration time.Duration
+	)
+	for _, rec := range tc.executionHistory {
+		if rec.TrinityAspect != aspect {
+			continue
+		}
+		count++
+		quality += rec.QualityScore
+		duration += rec.Duration
+		if rec.Status == StatusCompleted {
+			success++
+		}
+	}
+
+	current := AspectQualityMetrics{ExecutionCount: count}
+	if count > 0 {
+		current.SuccessRate = float64(success) / float64(count)
+		current.AverageQuality = quality / float64(count)
+		current.AverageDuration = duration / time.Duration(count)
+	}
+
+	if tc.qualityMetrics.AspectMetrics == nil {
+		tc.qualityMetrics.AspectMetrics = make(map[TrinityAspect]AspectQualityMetrics)
+	}
+	previous := tc.qualityMetrics.AspectMetrics[aspect]
+	tc.qualityMetrics.AspectMetrics[aspect] = current
+	tc.qualityMetrics.LastUpdated = time.Now()
+
+	tc.mu.Unlock()
+
+	trend := TrendUnknown
+	switch {
+	case previous.ExecutionCount == 0:
+		trend = TrendUnknown
+	case current.AverageQuality > previous.AverageQuality:
+		trend = TrendImproving
+	case current.AverageQuality < previous.AverageQuality:
+		trend = TrendDeclining
+	default:
+		trend = TrendStable
+	}
+
+	tc.publishAspectQuality(aspect, current, trend)
+}
@@ -0,0 +1,62 @@
+package subagent
+
+import (
+	"context"
+	"time"
+)
+
+// recordOperation appends op to the workflow's operation history, used by
+// Client.ListOperations/GetOperation.
+func (tc *TrinityCoordinator) recordOperation(op Operation) {
+	tc.operationsMu.Lock()
+	defer tc.operationsMu.Unlock()
+
+	if tc.operations == nil {
+		tc.operations = make(map[string][]Operation)
+	}
+	tc.operations[op.WorkflowID] = append(tc.operations[op.WorkflowID], op)
+}
+
+// handleStepFailure decides, based on step.Task.RetryPolicy, whether a
+// failed step should move to StatusRetrying (and be resubmitted after its
+// backoff) or settle at StatusFailed. It records the corresponding
+// Operation either way.
+//
+// attempt is 1-indexed: the first failure is attempt 1. Ctx cancellation
+// interrupts the backoff wait and returns ctx.Err().
+func (tc *TrinityCoordinator) handleStepFailure(ctx context.Context, wf *Workflow, step *WorkflowStep, attempt int, cause error) (Status, error) {
+	policy := step.Task.RetryPolicy
+
+	op := Operation{
+		ID:            wf.ID + ":" + step.ID + ":" + time.Now().UTC().Format(time.RFC3339Nano),
+		WorkflowID:    wf.ID,
+		StepID:        step.ID,
+		TrinityAspect: step.TrinityAspect,
+		Attempt:       attempt,
+		StartTime:     time.Now(),
+	}
+	if cause != nil {
+		op.Error = cause.Error()
+	}
+
+	if policy == nil || attempt >= policy.MaxAttempts {
+		op.Status = StatusFailed
+		op.EndTime = time.Now()
+		tc.recordOperation(op)
+		return StatusFailed, nil
+	}
+
+	op.Status = StatusRetrying
+	tc.recordOperation(op)
+
+	delay := policy.DelayForAttempt(attempt)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return StatusPending, nil
+	case <-ctx.Done():
+		return StatusRetrying, ctx.Err()
+	}
+}
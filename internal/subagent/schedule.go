@@ -0,0 +1,163 @@
+package subagent
+
+import (
+	"context"
+	"time"
+)
+
+// ConvertSliceOfDaysToMap builds a lookup set from a Schedule's
+// AvailableDays so membership checks don't need a linear scan.
+func ConvertSliceOfDaysToMap(days []time.Weekday) map[time.Weekday]bool {
+	m := make(map[time.Weekday]bool, len(days))
+	for _, d := range days {
+		m[d] = true
+	}
+	return m
+}
+
+// FirstAvailableDayDiff returns the number of days from now until the next
+// day allowed by avail, counting today as 0 if it is itself allowed. An
+// empty avail map means every day is available, so it always returns 0.
+func FirstAvailableDayDiff(now time.Weekday, avail map[time.Weekday]bool) int {
+	if len(avail) == 0 || avail[now] {
+		return 0
+	}
+	return NextAvailableDayDiff(now, avail)
+}
+
+// NextAvailableDayDiff is like FirstAvailableDayDiff but always skips today,
+// returning the number of days (1-7) until the next allowed weekday. An
+// empty avail map means every day is available, so it returns 1 (tomorrow).
+func NextAvailableDayDiff(now time.Weekday, avail map[time.Weekday]bool) int {
+	for i := 1; i <= 7; i++ {
+		candidate := time.Weekday((int(now) + i) % 7)
+		if len(avail) == 0 || avail[candidate] {
+			return i
+		}
+	}
+	// Unreachable when avail contains at least one allowed day.
+	return 7
+}
+
+// windowWraps reports whether a MaintenanceWindow's end time-of-day is
+// earlier than its start time-of-day, e.g. a nightly 22:00-02:00 window,
+// meaning the window that starts on a given day doesn't end until the
+// following day.
+func windowWraps(start, end time.Time) bool {
+	startOfDay := start.Hour()*3600 + start.Minute()*60 + start.Second()
+	endOfDay := end.Hour()*3600 + end.Minute()*60 + end.Second()
+	return endOfDay < startOfDay
+}
+
+// nextAvailableWindow computes the next time at or after from that falls
+// inside s's MaintenanceWindow on an allowed weekday, evaluated using
+// wall-clock comparisons in s's configured Timezone so DST transitions
+// don't shift the intended time-of-day. A window whose End time-of-day is
+// earlier than its Start (e.g. 22:00-02:00) is treated as spanning
+// midnight into the following day.
+func (s *Schedule) nextAvailableWindow(from time.Time) (time.Time, error) {
+	loc := time.UTC
+	if s.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(s.Timezone)
+		if err != nil {
+			return time.Time{}, NewFieldValidationError("timezone", "invalid schedule timezone: "+s.Timezone)
+		}
+	}
+
+	local := from.In(loc)
+	avail := ConvertSliceOfDaysToMap(s.AvailableDays)
+
+	windowStart := s.MaintenanceWindow.Start
+	windowEnd := s.MaintenanceWindow.End
+	wraps := windowWraps(windowStart, windowEnd)
+
+	if wraps {
+		// A wrapping window that started yesterday may still be open now;
+		// check that before looking at today's own window.
+		yesterday := local.AddDate(0, 0, -1).Weekday()
+		if len(avail) == 0 || avail[yesterday] {
+			end := time.Date(local.Year(), local.Month(), local.Day(),
+				windowEnd.Hour(), windowEnd.Minute(), windowEnd.Second(), 0, loc)
+			if local.Before(end) {
+				return local, nil
+			}
+		}
+	}
+
+	dayDiff := FirstAvailableDayDiff(local.Weekday(), avail)
+	candidateDay := local.AddDate(0, 0, dayDiff)
+
+	start := time.Date(candidateDay.Year(), candidateDay.Month(), candidateDay.Day(),
+		windowStart.Hour(), windowStart.Minute(), windowStart.Second(), 0, loc)
+	end := time.Date(candidateDay.Year(), candidateDay.Month(), candidateDay.Day(),
+		windowEnd.Hour(), windowEnd.Minute(), windowEnd.Second(), 0, loc)
+	if wraps {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	if dayDiff == 0 {
+		if !local.Before(start) && local.Before(end) {
+			// Already inside today's window.
+			return local, nil
+		}
+		if local.Before(start) {
+			return start, nil
+		}
+		// Today's window already passed; advance to the next allowed day.
+		dayDiff = NextAvailableDayDiff(local.Weekday(), avail)
+		candidateDay = local.AddDate(0, 0, dayDiff)
+		start = time.Date(candidateDay.Year(), candidateDay.Month(), candidateDay.Day(),
+			windowStart.Hour(), windowStart.Minute(), windowStart.Second(), 0, loc)
+	}
+
+	return start, nil
+}
+
+// awaitMaintenanceWindow blocks, if necessary, until wf's Schedule allows a
+// step to be dispatched. It returns immediately (with a zero wait) when
+// wf.Schedule is nil. While waiting, wf is transitioned to
+// WorkflowStatePaused with ResumeAt set; ctx cancellation interrupts the
+// wait and restores wf.State/ResumeAt to their previous values. wf.State and
+// wf.ResumeAt are changed under tc.workflowMu, matching Client.GetWorkflow
+// and Client.ListWorkflows, which read *wf under the same lock; wf.Schedule
+// is read without it.
+func (tc *TrinityCoordinator) awaitMaintenanceWindow(ctx context.Context, wf *Workflow) error {
+	if wf.Schedule == nil {
+		return nil
+	}
+
+	next, err := wf.Schedule.nextAvailableWindow(time.Now())
+	if err != nil {
+		return err
+	}
+
+	wait := time.Until(next)
+	if wait <= 0 {
+		return nil
+	}
+
+	tc.workflowMu.Lock()
+	previousState := wf.State
+	wf.State = WorkflowStatePaused
+	wf.ResumeAt = &next
+	tc.workflowMu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		tc.workflowMu.Lock()
+		wf.State = previousState
+		wf.ResumeAt = nil
+		tc.workflowMu.Unlock()
+		return nil
+	case <-ctx.Done():
+		tc.workflowMu.Lock()
+		wf.State = previousState
+		wf.ResumeAt = nil
+		tc.workflowMu.Unlock()
+		return ctx.Err()
+	}
+}
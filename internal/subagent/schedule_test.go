@@ -0,0 +1,100 @@
+package subagent
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDayDiffWraparound(t *testing.T) {
+	avail := ConvertSliceOfDaysToMap([]time.Weekday{time.Monday, time.Wednesday})
+
+	if got := FirstAvailableDayDiff(time.Monday, avail); got != 0 {
+		t.Fatalf("FirstAvailableDayDiff(Monday) = %d, want 0", got)
+	}
+	if got := FirstAvailableDayDiff(time.Tuesday, avail); got != 1 {
+		t.Fatalf("FirstAvailableDayDiff(Tuesday) = %d, want 1", got)
+	}
+	// Thursday must wrap all the way around to next Monday (4 days).
+	if got := FirstAvailableDayDiff(time.Thursday, avail); got != 4 {
+		t.Fatalf("FirstAvailableDayDiff(Thursday) = %d, want 4", got)
+	}
+	if got := NextAvailableDayDiff(time.Monday, avail); got != 2 {
+		t.Fatalf("NextAvailableDayDiff(Monday) = %d, want 2 (skips today)", got)
+	}
+	if got := FirstAvailableDayDiff(time.Sunday, map[time.Weekday]bool{}); got != 0 {
+		t.Fatalf("FirstAvailableDayDiff with empty avail = %d, want 0 (always available)", got)
+	}
+}
+
+func TestNextAvailableWindowWrapsMidnight(t *testing.T) {
+	s := &Schedule{
+		MaintenanceWindow: TimeWindow{
+			Start: time.Date(0, 1, 1, 22, 0, 0, 0, time.UTC),
+			End:   time.Date(0, 1, 1, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	// 23:00 is inside the window that started at 22:00 the same day.
+	now := time.Date(2026, 1, 5, 23, 0, 0, 0, time.UTC)
+	got, err := s.nextAvailableWindow(now)
+	if err != nil {
+		t.Fatalf("nextAvailableWindow: %v", err)
+	}
+	if !got.Equal(now) {
+		t.Fatalf("got %v, want %v (already inside window)", got, now)
+	}
+
+	// 01:00 is inside the window that started the previous day at 22:00.
+	now = time.Date(2026, 1, 5, 1, 0, 0, 0, time.UTC)
+	got, err = s.nextAvailableWindow(now)
+	if err != nil {
+		t.Fatalf("nextAvailableWindow: %v", err)
+	}
+	if !got.Equal(now) {
+		t.Fatalf("got %v, want %v (already inside window carried over from yesterday)", got, now)
+	}
+
+	// 10:00 is outside the window; the next start is 22:00 the same day.
+	now = time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	want := time.Date(2026, 1, 5, 22, 0, 0, 0, time.UTC)
+	got, err = s.nextAvailableWindow(now)
+	if err != nil {
+		t.Fatalf("nextAvailableWindow: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestAwaitMaintenanceWindowCancelRestoresState(t *testing.T) {
+	wf := &Workflow{
+		ID:    "wf-sched",
+		State: WorkflowStatePending,
+		Schedule: &Schedule{
+			AvailableDays: []time.Weekday{time.Now().Add(48 * time.Hour).Weekday()},
+			MaintenanceWindow: TimeWindow{
+				Start: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(0, 1, 1, 0, 0, 1, 0, time.UTC),
+			},
+		},
+	}
+	tc := &TrinityCoordinator{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tc.awaitMaintenanceWindow(ctx, wf) }()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-done; err == nil {
+		t.Fatal("expected awaitMaintenanceWindow to return ctx.Err() on cancellation")
+	}
+	if wf.State != WorkflowStatePending {
+		t.Fatalf("got state %v after cancellation, want restored to pending", wf.State)
+	}
+	if wf.ResumeAt != nil {
+		t.Fatalf("got ResumeAt %v after cancellation, want nil", wf.ResumeAt)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"context"
 	"sync"
 	"time"
+
+	"github.com/apto-as/trinitas-agents/internal/subagent/metrics"
 )
 
 // Agent represents a specialized AI agent capable of executing specific tasks.
@@ -41,6 +43,37 @@ type Task struct {
 	// Trinity-specific metadata
 	RequiredAspects []TrinityAspect `json:"required_aspects"`
 	Dependencies    []string        `json:"dependencies"`
+
+	// RetryPolicy governs automatic retries after failure. A nil policy
+	// means a failed task stays StatusFailed.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+}
+
+// RetryPolicy bounds how many times a failed task is automatically retried
+// and how long the coordinator waits between attempts.
+type RetryPolicy struct {
+	MaxAttempts  int           `json:"max_attempts"`
+	InitialDelay time.Duration `json:"initial_delay"`
+	MaxDelay     time.Duration `json:"max_delay"`
+}
+
+// DelayForAttempt returns the backoff delay before the given retry attempt
+// (1-indexed), doubling InitialDelay each attempt and capping at MaxDelay.
+func (rp RetryPolicy) DelayForAttempt(attempt int) time.Duration {
+	if attempt <= 1 {
+		if rp.MaxDelay > 0 && rp.InitialDelay > rp.MaxDelay {
+			return rp.MaxDelay
+		}
+		return rp.InitialDelay
+	}
+	delay := rp.InitialDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if rp.MaxDelay > 0 && delay > rp.MaxDelay {
+			return rp.MaxDelay
+		}
+	}
+	return delay
 }
 
 // Result represents the outcome of task execution with comprehensive metadata.
@@ -97,6 +130,31 @@ type TrinityCoordinator struct {
 	// Quality metrics
 	executionHistory []ExecutionRecord
 	qualityMetrics   QualityMetrics
+
+	// Operation tracking, surfaced read-only through Client.
+	operationsMu sync.RWMutex
+	operations   map[string][]Operation
+
+	// injector resolves "$(VAR)" references before each step dispatch.
+	injector *Injector
+
+	// metricsCollector exports execution/quality data; defaults to
+	// metrics.Default() when nil.
+	metricsCollector metrics.Collector
+}
+
+// Operation records the execution of a single WorkflowStep within a
+// Workflow, surfaced through Client.ListOperations/GetOperation.
+type Operation struct {
+	ID            string        `json:"id"`
+	WorkflowID    string        `json:"workflow_id"`
+	StepID        string        `json:"step_id"`
+	Status        Status        `json:"status"`
+	TrinityAspect TrinityAspect `json:"trinity_aspect"`
+	Attempt       int           `json:"attempt"`
+	StartTime     time.Time     `json:"start_time"`
+	EndTime       time.Time     `json:"end_time,omitempty"`
+	Error         string        `json:"error,omitempty"`
 }
 
 // Workflow represents a multi-agent collaboration pattern.
@@ -115,6 +173,42 @@ type Workflow struct {
 	Results         map[string]any       `json:"results"`
 	CreatedAt       time.Time            `json:"created_at"`
 	UpdatedAt       time.Time            `json:"updated_at"`
+
+	// Variables are supplied at submit time and resolved by Injector for
+	// any "$(VAR)" reference in a step's Task that isn't a prior step
+	// result.
+	Variables       map[string]any       `json:"variables,omitempty"`
+
+	// Schedule restricts which wall-clock windows this workflow's steps may
+	// run in. A nil Schedule means "always available".
+	Schedule        *Schedule            `json:"schedule,omitempty"`
+	// ResumeAt is set when State is WorkflowStatePaused waiting on the next
+	// maintenance window; it is cleared once the workflow resumes.
+	ResumeAt        *time.Time           `json:"resume_at,omitempty"`
+}
+
+// Schedule defines the maintenance window a Workflow's steps are allowed to
+// run in, modeled after per-weekday availability in upstream orchestration
+// libraries.
+type Schedule struct {
+	// AvailableDays lists weekdays the workflow may run on. An empty slice
+	// means "every day".
+	AvailableDays []time.Weekday
+
+	// MaintenanceWindow bounds the time-of-day steps may run, evaluated in
+	// Timezone. Only the hour/minute/second components are used.
+	MaintenanceWindow TimeWindow
+
+	// Timezone is an IANA location name, e.g. "America/Los_Angeles". Empty
+	// means UTC.
+	Timezone string
+}
+
+// TimeWindow is a time-of-day range, e.g. 02:00-04:00 for a nightly
+// maintenance window.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
 }
 
 // WorkflowStep represents a single step in a workflow execution.
@@ -227,6 +321,10 @@ const (
 	StatusRunning   Status = "running"
 	StatusCompleted Status = "completed"
 	StatusFailed    Status = "failed"
+	// StatusRetrying marks a task that failed but is waiting on its
+	// RetryPolicy backoff before being resubmitted, rather than collapsing
+	// straight back to StatusPending.
+	StatusRetrying  Status = "retrying"
 	StatusCancelled Status = "cancelled"
 	StatusTimeout   Status = "timeout"
 )